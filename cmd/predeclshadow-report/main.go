@@ -0,0 +1,78 @@
+// Command predeclshadow-report runs the predeclshadow checks over one or
+// more packages and emits the results in a CI-friendly structured format
+// (JSON, SARIF, or checkstyle), rather than the plain text of `go vet`.
+//
+// Usage:
+//
+//	predeclshadow-report [-format=text|json|sarif|checkstyle] [-config=path] [-j=N] [-exit-code] patterns...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"os"
+	"runtime"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/cixel/predecl-shadows/predeclshadow"
+)
+
+func main() {
+	format := flag.String("format", "text", "output format: text, json, sarif, or checkstyle")
+	configPath := flag.String("config", "", "path to a YAML or JSON config file adding identifiers, severities, or file-level ignores")
+	exitCode := flag.Bool("exit-code", false, "exit with a non-zero status if any violations are found")
+	workers := flag.Int("j", runtime.GOMAXPROCS(0), "number of files to check concurrently")
+	flag.Parse()
+
+	formatter, ok := predeclshadow.Formatters[*format]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "predeclshadow-report: unrecognized -format %q\n", *format)
+		os.Exit(2)
+	}
+
+	var cfg *predeclshadow.Config
+	if *configPath != "" {
+		c, err := predeclshadow.LoadConfig(*configPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		cfg = c
+	}
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Fset:  fset,
+		Tests: true,
+	}, patterns...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var jobs []predeclshadow.FileJob
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Syntax {
+			jobs = append(jobs, predeclshadow.FileJob{Fset: fset, File: f, Cfg: cfg})
+		}
+	}
+
+	diags := predeclshadow.CheckFilesParallel(jobs, *workers)
+
+	if err := formatter.Format(os.Stdout, diags); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *exitCode && len(diags) > 0 {
+		os.Exit(1)
+	}
+}
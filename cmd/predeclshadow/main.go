@@ -0,0 +1,16 @@
+// Command predeclshadow reports identifiers that shadow one of Go's
+// predeclared identifiers. See the predeclshadow package for the analyzer
+// itself; this binary is a thin singlechecker wrapper so the same analyzer
+// can be run standalone, via `go vet -vettool=`, or from any other
+// go/analysis driver.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/cixel/predecl-shadows/predeclshadow"
+)
+
+func main() {
+	singlechecker.Main(predeclshadow.Analyzer)
+}
@@ -0,0 +1,108 @@
+// Package predeclshadow defines an analyzer that reports identifiers which
+// shadow one of Go's predeclared identifiers (the universe block: "error",
+// "len", "string", "nil", and so on), plus any project-specific identifiers
+// named via the -config flag.
+//
+// Package discovery is intentionally not this package's job: drivers built
+// on golang.org/x/tools/go/analysis (singlechecker, multichecker, go vet
+// -vettool=, golangci-lint) resolve the analyzer's input packages via
+// golang.org/x/tools/go/packages, which already honors module boundaries,
+// build tags, vendor/testdata conventions, and symlinked directories, and
+// includes _test.go files by default. There is no bespoke directory walker
+// here to duplicate that.
+package predeclshadow
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const doc = `report identifiers that shadow a predeclared identifier
+
+This analyzer flags short variable declarations, var/type declarations,
+function parameters/results/receivers, range variables, and type switch
+guards whose name matches one of Go's predeclared identifiers (e.g. naming
+a variable "len" or "error"), which can cause confusing compile errors or
+bugs elsewhere in the same scope.`
+
+// Analyzer reports shadows of Go's predeclared identifiers. It can be run
+// standalone via the cmd/predeclshadow binary, or plugged into any driver
+// that consumes golang.org/x/tools/go/analysis Analyzers (go vet
+// -vettool=..., golangci-lint, multichecker, etc).
+var Analyzer = &analysis.Analyzer{
+	Name:     "predeclshadow",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var configPath string
+
+func init() {
+	Analyzer.Flags.Init("predeclshadow", flag.ExitOnError)
+	Analyzer.Flags.StringVar(&configPath, "config", "", "path to a YAML or JSON config file adding identifiers, severities, or file-level ignores")
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	var cfg *Config
+	if configPath != "" {
+		c, err := LoadConfig(configPath)
+		if err != nil {
+			return nil, fmt.Errorf("predeclshadow: %w", err)
+		}
+		cfg = c
+	}
+
+	// Drivers like multichecker/golangci-lint run one Run per package
+	// concurrently, so the identifier set must be built locally rather than
+	// mutating the shared universe map.
+	ids := universeWith(cfg.extraIdentifiers())
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.GenDecl)(nil),
+		(*ast.FuncDecl)(nil),
+		(*ast.FuncLit)(nil),
+		(*ast.RangeStmt)(nil),
+		(*ast.TypeSwitchStmt)(nil),
+	}
+
+	insp.Preorder(nodeFilter, func(n ast.Node) {
+		filename := pass.Fset.Position(n.Pos()).Filename
+		if cfg.ignored(filename) {
+			return
+		}
+
+		for _, v := range violationsIn(n, ids) {
+			reportViolation(pass, cfg, v)
+		}
+	})
+
+	return nil, nil
+}
+
+// reportViolation turns a violation into an analysis.Diagnostic.
+//
+// This deliberately has no SuggestedFixes: renaming only the declaring
+// identifier, without also rewriting every reference to it in scope, can
+// turn a warning into a build break (e.g. `for len := range xs { _ = len }`
+// renamed at the declaration alone leaves a dangling use of the old name
+// and an unused new one). Offering that safely needs the uses resolved via
+// pass.TypesInfo, not just the declaring *ast.Ident.
+func reportViolation(pass *analysis.Pass, cfg *Config, v violation) {
+	severity := cfg.severityFor(v.id.Name)
+
+	pass.Report(analysis.Diagnostic{
+		Pos:      v.id.Pos(),
+		End:      v.id.End(),
+		Category: "predeclshadow",
+		Message:  fmt.Sprintf("[%s] %s %q shadows predeclared identifier", severity, v.desc, v.id.Name),
+	})
+}
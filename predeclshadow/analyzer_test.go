@@ -0,0 +1,14 @@
+package predeclshadow_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/cixel/predecl-shadows/predeclshadow"
+)
+
+func TestAnalyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, predeclshadow.Analyzer, "a")
+}
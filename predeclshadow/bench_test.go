@@ -0,0 +1,75 @@
+package predeclshadow_test
+
+import (
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/cixel/predecl-shadows/predeclshadow"
+)
+
+// stdlibJobs parses a large slice of the Go standard library's own source
+// (under GOROOT/src) as a realistic large corpus for benchmarking, capped so
+// the benchmark stays fast in CI.
+func stdlibJobs(b *testing.B) []predeclshadow.FileJob {
+	b.Helper()
+
+	root := filepath.Join(runtime.GOROOT(), "src")
+	fset := token.NewFileSet()
+
+	var jobs []predeclshadow.FileJob
+	const limit = 1500
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || len(jobs) >= limit {
+			return filepath.SkipAll
+		}
+		if d.IsDir() {
+			if d.Name() == "testdata" || d.Name() == "vendor" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil // skip files that don't parse standalone (build-tag variants, etc.)
+		}
+		jobs = append(jobs, predeclshadow.FileJob{Fset: fset, File: f})
+		return nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	if len(jobs) == 0 {
+		b.Skip("no stdlib sources found under GOROOT/src")
+	}
+
+	return jobs
+}
+
+func BenchmarkCheckSequential(b *testing.B) {
+	jobs := stdlibJobs(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, j := range jobs {
+			predeclshadow.CheckFile(j.Fset, j.File, j.Cfg)
+		}
+	}
+}
+
+func BenchmarkCheckParallel(b *testing.B) {
+	jobs := stdlibJobs(b)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		predeclshadow.CheckFilesParallel(jobs, runtime.GOMAXPROCS(0))
+	}
+}
@@ -0,0 +1,179 @@
+package predeclshadow
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// violation is a single shadowing site: the identifier responsible, and a
+// human description of the kind of site it was declared at (used to build
+// the diagnostic message).
+type violation struct {
+	id   *ast.Ident
+	desc string
+}
+
+// violationsIn dispatches a single AST node to the checkXxx function for its
+// kind, checking identifiers against ids (Go's predeclared universe plus any
+// config-supplied additions). It's the traversal glue shared by the
+// go/analysis Analyzer and the standalone CheckFile entry point.
+func violationsIn(n ast.Node, ids map[string]struct{}) []violation {
+	switch x := n.(type) {
+	case *ast.AssignStmt:
+		return checkAssign(x, ids)
+	case *ast.GenDecl:
+		return checkDecl(x, ids)
+	case *ast.FuncDecl:
+		return checkFuncDecl(x, ids)
+	case *ast.FuncLit:
+		return checkFuncLit(x, ids)
+	case *ast.RangeStmt:
+		return checkRangeStmt(x, ids)
+	case *ast.TypeSwitchStmt:
+		return checkTypeSwitchStmt(x, ids)
+	}
+	return nil
+}
+
+// isTypeSwitchGuard reports whether s is the `x := v.(type)` assignment
+// introducing a type switch's guard variable. The inspector also visits this
+// node as a plain *ast.AssignStmt (it's reachable via ast.Walk regardless of
+// the enclosing *ast.TypeSwitchStmt), so checkAssign skips it and leaves it
+// to checkTypeSwitchStmt, which can label it correctly.
+func isTypeSwitchGuard(s *ast.AssignStmt) bool {
+	if len(s.Rhs) != 1 {
+		return false
+	}
+	ta, ok := s.Rhs[0].(*ast.TypeAssertExpr)
+	return ok && ta.Type == nil
+}
+
+func checkAssign(s *ast.AssignStmt, ids map[string]struct{}) (found []violation) {
+	if s.Tok != token.DEFINE || isTypeSwitchGuard(s) {
+		return
+	}
+
+	for _, expr := range s.Lhs {
+		id, ok := expr.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		if shadowed(ids, id.Name) {
+			found = append(found, violation{id: id, desc: "short variable declaration"})
+		}
+	}
+
+	return
+}
+
+func checkDecl(d *ast.GenDecl, ids map[string]struct{}) (found []violation) {
+	switch d.Tok {
+	case token.VAR:
+		for _, spec := range d.Specs {
+			v, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+
+			for _, id := range v.Names {
+				if shadowed(ids, id.Name) {
+					found = append(found, violation{id: id, desc: "variable declaration"})
+				}
+			}
+		}
+
+	case token.TYPE:
+		for _, spec := range d.Specs {
+			t, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+
+			if shadowed(ids, t.Name.Name) {
+				found = append(found, violation{id: t.Name, desc: "type declaration"})
+			}
+		}
+	}
+
+	return
+}
+
+// checkFuncDecl inspects a function or method declaration's receiver,
+// parameters, and results for shadowing.
+func checkFuncDecl(d *ast.FuncDecl, ids map[string]struct{}) (found []violation) {
+	found = append(found, checkFieldList(d.Recv, "receiver", ids)...)
+	found = append(found, checkFieldList(d.Type.Params, "parameter", ids)...)
+	found = append(found, checkFieldList(d.Type.Results, "result parameter", ids)...)
+	return
+}
+
+// checkFuncLit inspects a function literal's parameters and results for
+// shadowing.
+func checkFuncLit(fl *ast.FuncLit, ids map[string]struct{}) (found []violation) {
+	found = append(found, checkFieldList(fl.Type.Params, "parameter", ids)...)
+	found = append(found, checkFieldList(fl.Type.Results, "result parameter", ids)...)
+	return
+}
+
+// checkFieldList checks every named field (params, results, or a receiver)
+// in fl for shadowed identifiers. fl may be nil, as with a func with no
+// receiver.
+func checkFieldList(fl *ast.FieldList, desc string, ids map[string]struct{}) (found []violation) {
+	if fl == nil {
+		return
+	}
+
+	for _, field := range fl.List {
+		for _, id := range field.Names {
+			if shadowed(ids, id.Name) {
+				found = append(found, violation{id: id, desc: desc})
+			}
+		}
+	}
+
+	return
+}
+
+// checkRangeStmt inspects the key and value identifiers of a `for k, v :=
+// range x` statement for shadowing. Range clauses using plain assignment
+// (`=` instead of `:=`) can't introduce a new shadow and are skipped.
+func checkRangeStmt(r *ast.RangeStmt, ids map[string]struct{}) (found []violation) {
+	if r.Tok != token.DEFINE {
+		return
+	}
+
+	for _, expr := range []ast.Expr{r.Key, r.Value} {
+		id, ok := expr.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		if shadowed(ids, id.Name) {
+			found = append(found, violation{id: id, desc: "range variable"})
+		}
+	}
+
+	return
+}
+
+// checkTypeSwitchStmt inspects a `switch x := v.(type)` guard variable for
+// shadowing. A type switch with no guard variable (`switch v.(type)`) has an
+// *ast.ExprStmt for Assign instead, and is skipped.
+func checkTypeSwitchStmt(ts *ast.TypeSwitchStmt, ids map[string]struct{}) (found []violation) {
+	s, ok := ts.Assign.(*ast.AssignStmt)
+	if !ok || len(s.Lhs) != 1 {
+		return
+	}
+
+	id, ok := s.Lhs[0].(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	if shadowed(ids, id.Name) {
+		found = append(found, violation{id: id, desc: "type switch guard"})
+	}
+
+	return
+}
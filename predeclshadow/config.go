@@ -0,0 +1,131 @@
+package predeclshadow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes user overrides to the default predeclared-identifier
+// checks, loaded from a YAML or JSON file via the -config flag.
+type Config struct {
+	// Identifiers are additional names to treat as shadow-able, on top of
+	// Go's predeclared universe (e.g. project-specific globals, or "any"
+	// and "comparable" on older Go versions).
+	Identifiers []string `json:"identifiers" yaml:"identifiers"`
+
+	// Severity maps an identifier name to "error" or "warning". Identifiers
+	// not listed here use DefaultSeverity.
+	Severity map[string]string `json:"severity" yaml:"severity"`
+
+	// DefaultSeverity applies to any identifier not named in Severity.
+	// Defaults to "warning".
+	DefaultSeverity string `json:"default_severity" yaml:"default_severity"`
+
+	// Ignore is a list of glob patterns (matched against the file path)
+	// whose violations are suppressed entirely. Useful for grandfathering
+	// existing offenders in a large monorepo.
+	Ignore []string `json:"ignore" yaml:"ignore"`
+}
+
+// LoadConfig reads and parses a Config from path, selecting a decoder based
+// on the file extension (.yaml, .yml, or .json).
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("parsing %s as yaml: %w", path, err)
+		}
+	case ".json":
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("parsing %s as json: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized config extension %q (want .yaml, .yml, or .json)", ext)
+	}
+
+	if cfg.DefaultSeverity == "" {
+		cfg.DefaultSeverity = "warning"
+	}
+
+	return cfg, nil
+}
+
+// severityFor returns the configured severity for id, falling back to
+// DefaultSeverity when id has no entry in Severity.
+func (c *Config) severityFor(id string) string {
+	if c == nil {
+		return "warning"
+	}
+	if s, ok := c.Severity[id]; ok {
+		return s
+	}
+	return c.DefaultSeverity
+}
+
+// ignored reports whether file matches one of the configured Ignore glob
+// patterns. Patterns are checked against every path-segment suffix of file
+// (not just the full path or the bare basename), so a directory-scoped
+// pattern like "vendor/*" matches regardless of how deep under vendor/ the
+// file lives, and regardless of whether file is absolute (as go/packages
+// hands back) or relative.
+func (c *Config) ignored(file string) bool {
+	if c == nil {
+		return false
+	}
+
+	segs := strings.Split(filepath.ToSlash(filepath.Clean(file)), "/")
+
+	for _, pattern := range c.Ignore {
+		pattern = filepath.ToSlash(pattern)
+		for i := range segs {
+			if globMatch(pattern, segs[i:]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// globMatch reports whether pattern matches the path made of pathSegs,
+// joined with "/". Each "/"-separated element of pattern is matched against
+// the corresponding path segment with filepath.Match, except that a bare
+// trailing "*" element matches every remaining segment, so a pattern doesn't
+// need an explicit "**" to grandfather an entire subtree.
+func globMatch(pattern string, pathSegs []string) bool {
+	patSegs := strings.Split(pattern, "/")
+
+	for i, pat := range patSegs {
+		if i == len(patSegs)-1 && pat == "*" {
+			return true
+		}
+		if i >= len(pathSegs) {
+			return false
+		}
+		if ok, _ := filepath.Match(pat, pathSegs[i]); !ok {
+			return false
+		}
+	}
+
+	return len(patSegs) == len(pathSegs)
+}
+
+// extraIdentifiers returns cfg.Identifiers, or nil if cfg is nil.
+func (c *Config) extraIdentifiers() []string {
+	if c == nil {
+		return nil
+	}
+	return c.Identifiers
+}
@@ -0,0 +1,96 @@
+package predeclshadow
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTemp(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigJSON(t *testing.T) {
+	path := writeTemp(t, "cfg.json", `{
+		"identifiers": ["Logger"],
+		"severity": {"Logger": "error"},
+		"default_severity": "warning",
+		"ignore": ["vendor/*"]
+	}`)
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := cfg.severityFor("Logger"); got != "error" {
+		t.Errorf("severityFor(Logger) = %q, want error", got)
+	}
+	if got := cfg.severityFor("len"); got != "warning" {
+		t.Errorf("severityFor(len) = %q, want warning", got)
+	}
+	if !cfg.ignored("vendor/foo.go") {
+		t.Error("expected vendor/foo.go to be ignored")
+	}
+}
+
+func TestLoadConfigYAML(t *testing.T) {
+	path := writeTemp(t, "cfg.yaml", "identifiers:\n  - Logger\nseverity:\n  Logger: error\n")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(cfg.Identifiers) != 1 || cfg.Identifiers[0] != "Logger" {
+		t.Errorf("Identifiers = %v, want [Logger]", cfg.Identifiers)
+	}
+	if got := cfg.severityFor("Logger"); got != "error" {
+		t.Errorf("severityFor(Logger) = %q, want error", got)
+	}
+}
+
+func TestLoadConfigUnrecognizedExtension(t *testing.T) {
+	path := writeTemp(t, "cfg.toml", "identifiers = []")
+
+	if _, err := LoadConfig(path); err == nil {
+		t.Error("expected an error for an unrecognized extension")
+	}
+}
+
+func TestConfigIgnoredNestedDirectory(t *testing.T) {
+	cfg := &Config{Ignore: []string{"generated/*"}}
+
+	tests := []struct {
+		file string
+		want bool
+	}{
+		{"generated/thing/a.go", true},
+		{"/repo/generated/thing/deep/a.go", true},
+		{"generated/a.go", true},
+		{"other/generated/a.go", true},
+		{"other/a.go", false},
+	}
+
+	for _, tt := range tests {
+		if got := cfg.ignored(tt.file); got != tt.want {
+			t.Errorf("ignored(%q) = %v, want %v", tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestConfigIgnoredBasenamePattern(t *testing.T) {
+	cfg := &Config{Ignore: []string{"*.pb.go"}}
+
+	if !cfg.ignored("/repo/api/service.pb.go") {
+		t.Error("expected service.pb.go to be ignored")
+	}
+	if cfg.ignored("/repo/api/service.go") {
+		t.Error("expected service.go not to be ignored")
+	}
+}
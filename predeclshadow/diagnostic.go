@@ -0,0 +1,16 @@
+package predeclshadow
+
+// Diagnostic is a single reported shadowing site, in a form independent of
+// go/analysis so it can be serialized by the various -format encoders in
+// cmd/predeclshadow-report.
+type Diagnostic struct {
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Col        int    `json:"col"`
+	EndLine    int    `json:"end_line"`
+	EndCol     int    `json:"end_col"`
+	Identifier string `json:"identifier"`
+	Kind       string `json:"kind"`
+	Severity   string `json:"severity"`
+	Message    string `json:"message"`
+}
@@ -0,0 +1,236 @@
+package predeclshadow
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// Formatter renders a set of Diagnostics for a CI-consumable format.
+type Formatter interface {
+	Format(w io.Writer, diags []Diagnostic) error
+}
+
+// Formatters, keyed by the -format flag value accepted by
+// cmd/predeclshadow-report.
+var Formatters = map[string]Formatter{
+	"text":       TextFormatter{},
+	"json":       JSONFormatter{},
+	"sarif":      SARIFFormatter{},
+	"checkstyle": CheckstyleFormatter{},
+}
+
+// TextFormatter prints one human-readable "file:line:col: message" line per
+// diagnostic, matching the plain-text output of the go/analysis Analyzer.
+type TextFormatter struct{}
+
+func (TextFormatter) Format(w io.Writer, diags []Diagnostic) error {
+	for _, d := range diags {
+		if _, err := fmt.Fprintf(w, "%s:%d:%d: [%s] %s\n", d.File, d.Line, d.Col, d.Severity, d.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSONFormatter emits one JSON object per diagnostic, one per line (JSON
+// Lines), matching the {file,line,col,end_line,end_col,identifier,kind,
+// message} schema.
+type JSONFormatter struct{}
+
+func (JSONFormatter) Format(w io.Writer, diags []Diagnostic) error {
+	enc := json.NewEncoder(w)
+	for _, d := range diags {
+		if err := enc.Encode(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SARIF 2.1.0 types, trimmed to the fields this tool populates.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID       string            `json:"ruleId"`
+	Level        string            `json:"level"`
+	Message      sarifMessage      `json:"message"`
+	Locations    []sarifLocation   `json:"locations"`
+	Fingerprints map[string]string `json:"fingerprints,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// sarifLevel maps our severity strings onto SARIF's "error"/"warning"/"note"
+// levels.
+func sarifLevel(severity string) string {
+	switch severity {
+	case "error":
+		return "error"
+	case "warning":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// SARIFFormatter emits a single SARIF 2.1.0 log with one run.
+type SARIFFormatter struct{}
+
+func (SARIFFormatter) Format(w io.Writer, diags []Diagnostic) error {
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:  "predeclshadow",
+						Rules: []sarifRule{{ID: "predeclshadow"}},
+					},
+				},
+				Results: make([]sarifResult, 0, len(diags)),
+			},
+		},
+	}
+
+	for _, d := range diags {
+		log.Runs[0].Results = append(log.Runs[0].Results, sarifResult{
+			RuleID: "predeclshadow",
+			Level:  sarifLevel(d.Severity),
+			Message: sarifMessage{
+				Text: d.Message,
+			},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: d.File},
+						Region: sarifRegion{
+							StartLine:   d.Line,
+							StartColumn: d.Col,
+							EndLine:     d.EndLine,
+							EndColumn:   d.EndCol,
+						},
+					},
+				},
+			},
+			Fingerprints: map[string]string{
+				"predeclshadow/v1": fmt.Sprintf("%s:%d:%d:%s", d.File, d.Line, d.Col, d.Identifier),
+			},
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// Checkstyle XML, as consumed by Jenkins' Checkstyle plugin and GitLab Code
+// Quality reports.
+
+type checkstyleResult struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// CheckstyleFormatter emits checkstyle-XML, grouping diagnostics by file.
+type CheckstyleFormatter struct{}
+
+func (CheckstyleFormatter) Format(w io.Writer, diags []Diagnostic) error {
+	result := checkstyleResult{Version: "8.0"}
+
+	byFile := map[string]*checkstyleFile{}
+	var order []string
+	for _, d := range diags {
+		cf, ok := byFile[d.File]
+		if !ok {
+			cf = &checkstyleFile{Name: d.File}
+			byFile[d.File] = cf
+			order = append(order, d.File)
+		}
+		cf.Errors = append(cf.Errors, checkstyleError{
+			Line:     d.Line,
+			Column:   d.Col,
+			Severity: d.Severity,
+			Message:  d.Message,
+			Source:   "predeclshadow." + d.Kind,
+		})
+	}
+
+	for _, name := range order {
+		result.Files = append(result.Files, *byFile[name])
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(result); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
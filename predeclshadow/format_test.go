@@ -0,0 +1,70 @@
+package predeclshadow_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cixel/predecl-shadows/predeclshadow"
+)
+
+var sampleDiags = []predeclshadow.Diagnostic{
+	{
+		File: "a.go", Line: 3, Col: 8, EndLine: 3, EndCol: 11,
+		Identifier: "len", Kind: "parameter", Severity: "warning",
+		Message: `parameter "len" shadows predeclared identifier`,
+	},
+}
+
+func TestJSONFormatterSchema(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (predeclshadow.JSONFormatter{}).Format(&buf, sampleDiags); err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON line: %v", err)
+	}
+
+	for _, key := range []string{"file", "line", "col", "end_line", "end_col", "identifier", "kind", "message"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("missing %q key in JSON output: %v", key, got)
+		}
+	}
+}
+
+func TestSARIFFormatterShape(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (predeclshadow.SARIFFormatter{}).Format(&buf, sampleDiags); err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v", err)
+	}
+
+	if got["version"] != "2.1.0" {
+		t.Errorf("version = %v, want 2.1.0", got["version"])
+	}
+	if _, ok := got["runs"]; !ok {
+		t.Error("missing runs[]")
+	}
+}
+
+func TestCheckstyleFormatterShape(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (predeclshadow.CheckstyleFormatter{}).Format(&buf, sampleDiags); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, `<checkstyle version="8.0">`) {
+		t.Errorf("missing checkstyle root element: %s", out)
+	}
+	if !strings.Contains(out, `name="a.go"`) {
+		t.Errorf("missing file entry: %s", out)
+	}
+}
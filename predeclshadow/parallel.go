@@ -0,0 +1,111 @@
+package predeclshadow
+
+import (
+	"go/ast"
+	"go/token"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// FileJob is one unit of work for CheckFilesParallel: a parsed file, its
+// FileSet, and the config to check it against.
+type FileJob struct {
+	Fset *token.FileSet
+	File *ast.File
+	Cfg  *Config
+}
+
+// CheckFilesParallel runs CheckFile over jobs using a bounded pool of
+// workers (defaulting to runtime.GOMAXPROCS(0) when workers <= 0), then
+// funnels every worker's results through a single collector that sorts them
+// by (file, line, col) before returning. This keeps output deterministic
+// regardless of which worker finishes first or how files are interleaved
+// across packages.
+//
+// Diagnostics are also deduped by (file, line, col): golang.org/x/tools/go/packages
+// hands back a separate package variant for the "internal test" build (e.g.
+// "foo [foo.test]") alongside the plain "foo" package, and both variants
+// share the same non-test source files parsed into distinct *ast.Files with
+// identical positions. Without deduping, every violation in a tested package
+// would be reported once per variant.
+func CheckFilesParallel(jobs []FileJob, workers int) []Diagnostic {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(jobs) {
+		workers = len(jobs)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobCh := make(chan FileJob)
+	resultCh := make(chan []Diagnostic)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobCh {
+				resultCh <- CheckFile(j.Fset, j.File, j.Cfg)
+			}
+		}()
+	}
+
+	go func() {
+		for _, j := range jobs {
+			jobCh <- j
+		}
+		close(jobCh)
+	}()
+
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	var diags []Diagnostic
+	for ds := range resultCh {
+		diags = append(diags, ds...)
+	}
+
+	sort.Slice(diags, func(i, j int) bool {
+		a, b := diags[i], diags[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Col < b.Col
+	})
+
+	return dedupeByPosition(diags)
+}
+
+// dedupeKey identifies a diagnostic by where it was reported, regardless of
+// which package variant produced it.
+type dedupeKey struct {
+	file string
+	line int
+	col  int
+}
+
+// dedupeByPosition drops diagnostics that share a (file, line, col) with one
+// already kept. diags must already be sorted by (file, line, col), so
+// duplicates are adjacent.
+func dedupeByPosition(diags []Diagnostic) []Diagnostic {
+	var seen dedupeKey
+	out := diags[:0]
+	for i, d := range diags {
+		key := dedupeKey{d.File, d.Line, d.Col}
+		if i > 0 && key == seen {
+			continue
+		}
+		seen = key
+		out = append(out, d)
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package predeclshadow_test
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/cixel/predecl-shadows/predeclshadow"
+)
+
+func mustParse(t testing.TB, fset *token.FileSet, name, src string) *predeclshadow.FileJob {
+	t.Helper()
+	f, err := parser.ParseFile(fset, name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &predeclshadow.FileJob{Fset: fset, File: f}
+}
+
+// TestCheckFilesParallelHonorsConfig guards against the config-supplied
+// Identifiers silently being dropped on the CheckFilesParallel path (the one
+// cmd/predeclshadow-report drives): each job carries its own *Config, and
+// CheckFile must build its identifier set from that config rather than some
+// package-level default.
+func TestCheckFilesParallelHonorsConfig(t *testing.T) {
+	fset := token.NewFileSet()
+	job := mustParse(t, fset, "a.go", "package p\nfunc f() {\n\tCustom := 1\n\t_ = Custom\n}\n")
+	job.Cfg = &predeclshadow.Config{Identifiers: []string{"Custom"}}
+
+	diags := predeclshadow.CheckFilesParallel([]predeclshadow.FileJob{*job}, 1)
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics, want 1 (custom identifier %q should have been flagged)", len(diags), "Custom")
+	}
+	if diags[0].Identifier != "Custom" {
+		t.Errorf("diagnostic identifier = %q, want %q", diags[0].Identifier, "Custom")
+	}
+}
+
+// TestCheckFilesParallelDedupesTestVariants guards against the duplicate
+// diagnostics produced when golang.org/x/tools/go/packages hands back both
+// the plain "foo" package and its "foo [foo.test]" variant for a package
+// with an internal test file: both variants parse the same non-test source
+// into distinct *ast.Files at identical positions, so cmd/predeclshadow-report
+// ends up with two FileJobs for the same file. CheckFilesParallel must dedupe
+// by (file, line, col) rather than reporting the same violation twice.
+func TestCheckFilesParallelDedupesTestVariants(t *testing.T) {
+	const src = "package p\nfunc f() {\n\tlen := 1\n\t_ = len\n}\n"
+
+	// Simulate the two package variants: same filename, each parsed into its
+	// own *ast.File (as go/packages does per variant), both checked.
+	fset := token.NewFileSet()
+	plain := mustParse(t, fset, "a.go", src)
+	testVariant := mustParse(t, fset, "a.go", src)
+
+	diags := predeclshadow.CheckFilesParallel([]predeclshadow.FileJob{*plain, *testVariant}, 1)
+	if len(diags) != 1 {
+		t.Fatalf("got %d diagnostics, want 1 (duplicate package variant should have been deduped): %+v", len(diags), diags)
+	}
+}
+
+func TestCheckFilesParallelDeterministicOrder(t *testing.T) {
+	fset := token.NewFileSet()
+	var jobs []predeclshadow.FileJob
+	for i, src := range []string{
+		"package p\nvar string int\n",
+		"package p\nvar byte int\n",
+		"package p\nvar error int\n",
+		"package p\nvar len int\n",
+	} {
+		jobs = append(jobs, *mustParse(t, fset, string(rune('a'+i))+".go", src))
+	}
+
+	var want []predeclshadow.Diagnostic
+	for workers := 1; workers <= 8; workers++ {
+		got := predeclshadow.CheckFilesParallel(jobs, workers)
+		if want == nil {
+			want = got
+			continue
+		}
+		if len(got) != len(want) {
+			t.Fatalf("workers=%d: got %d diagnostics, want %d", workers, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("workers=%d: diagnostic %d = %+v, want %+v (non-deterministic ordering)", workers, i, got[i], want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package predeclshadow_test
+
+import (
+	"go/parser"
+	"go/token"
+	"sync"
+	"testing"
+
+	"github.com/cixel/predecl-shadows/predeclshadow"
+)
+
+// TestCheckFileConcurrentConfigsNoRace exercises CheckFile the way a
+// go/analysis driver calls Run: once per package, concurrently, each with
+// its own Config. It guards against regressing to a shared mutable universe
+// map (run with -race to catch it).
+func TestCheckFileConcurrentConfigsNoRace(t *testing.T) {
+	src := `package p
+func f() {
+	Custom := 1
+	_ = Custom
+}
+`
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			fset := token.NewFileSet()
+			f, err := parser.ParseFile(fset, "p.go", src, 0)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			cfg := &predeclshadow.Config{Identifiers: []string{"Custom"}}
+			diags := predeclshadow.CheckFile(fset, f, cfg)
+			if len(diags) != 1 {
+				t.Errorf("got %d diagnostics, want 1", len(diags))
+			}
+		}()
+	}
+	wg.Wait()
+}
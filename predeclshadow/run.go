@@ -0,0 +1,47 @@
+package predeclshadow
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+// CheckFile runs the same shadow checks as Analyzer against a single parsed
+// file and returns the results as Diagnostics, independent of go/analysis.
+// It's the entry point for standalone tools (see cmd/predeclshadow-report)
+// that need structured output rather than a pass.Report-driven driver.
+func CheckFile(fset *token.FileSet, f *ast.File, cfg *Config) []Diagnostic {
+	filename := fset.Position(f.Pos()).Filename
+	if cfg.ignored(filename) {
+		return nil
+	}
+
+	ids := universeWith(cfg.extraIdentifiers())
+
+	var diags []Diagnostic
+	ast.Inspect(f, func(n ast.Node) bool {
+		for _, v := range violationsIn(n, ids) {
+			diags = append(diags, toDiagnostic(fset, cfg, v))
+		}
+		return true
+	})
+
+	return diags
+}
+
+func toDiagnostic(fset *token.FileSet, cfg *Config, v violation) Diagnostic {
+	start := fset.Position(v.id.Pos())
+	end := fset.Position(v.id.End())
+
+	return Diagnostic{
+		File:       start.Filename,
+		Line:       start.Line,
+		Col:        start.Column,
+		EndLine:    end.Line,
+		EndCol:     end.Column,
+		Identifier: v.id.Name,
+		Kind:       v.desc,
+		Severity:   cfg.severityFor(v.id.Name),
+		Message:    fmt.Sprintf("%s %q shadows predeclared identifier", v.desc, v.id.Name),
+	}
+}
@@ -0,0 +1,32 @@
+package a
+
+func f(len int) (error error) { // want `\[warning\] parameter "len" shadows predeclared identifier` `\[warning\] result parameter "error" shadows predeclared identifier`
+	return nil
+}
+
+func g(xs []int) {
+	for len := range xs { // want `\[warning\] range variable "len" shadows predeclared identifier`
+		_ = len
+	}
+
+	var string int // want `\[warning\] variable declaration "string" shadows predeclared identifier`
+	_ = string
+
+	nil := 1 // want `\[warning\] short variable declaration "nil" shadows predeclared identifier`
+	_ = nil
+}
+
+type byte struct{} // want `\[warning\] type declaration "byte" shadows predeclared identifier`
+
+type T struct{}
+
+func (string T) h() {} // want `\[warning\] receiver "string" shadows predeclared identifier`
+
+func k(v interface{}) {
+	switch error := v.(type) { // want `\[warning\] type switch guard "error" shadows predeclared identifier`
+	case int:
+		_ = error
+	default:
+		_ = error
+	}
+}
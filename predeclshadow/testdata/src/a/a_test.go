@@ -0,0 +1,8 @@
+package a
+
+import "testing"
+
+func TestShadowInTestFile(t *testing.T) {
+	len := 3 // want `\[warning\] short variable declaration "len" shadows predeclared identifier`
+	_ = len
+}
@@ -0,0 +1,49 @@
+package predeclshadow
+
+// https://golang.org/ref/spec#Predeclared_identifiers
+var universe = func() map[string]struct{} {
+	m := make(map[string]struct{})
+	ids := []string{
+		// Types:
+		"bool", "byte", "complex64", "complex128", "error", "float32", "float64",
+		"int", "int8", "int16", "int32", "int64", "rune", "string",
+		"uint", "uint8", "uint16", "uint32", "uint64", "uintptr",
+
+		// Constants:
+		"true", "false", "iota",
+
+		// Zero value:
+		"nil",
+
+		// Functions:
+		"append", "cap", "close", "complex", "copy", "delete", "imag", "len",
+		"make", "new", "panic", "print", "println", "real", "recover",
+	}
+
+	for _, s := range ids {
+		m[s] = struct{}{}
+	}
+
+	return m
+}()
+
+// universeWith returns a fresh copy of Go's predeclared universe with extra
+// identifiers (from a user config) added. It never mutates the shared
+// package-level universe map, since Run/CheckFile/the Analyzer may all be
+// invoked concurrently (across packages, or across goroutines in the same
+// process) with different configs.
+func universeWith(extra []string) map[string]struct{} {
+	m := make(map[string]struct{}, len(universe)+len(extra))
+	for id := range universe {
+		m[id] = struct{}{}
+	}
+	for _, id := range extra {
+		m[id] = struct{}{}
+	}
+	return m
+}
+
+func shadowed(ids map[string]struct{}, name string) bool {
+	_, ok := ids[name]
+	return ok
+}